@@ -0,0 +1,142 @@
+package helpers
+
+import (
+	"os"
+	"time"
+
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+	lumberjack "gopkg.in/natefinch/lumberjack.v2"
+)
+
+// defaultSamplingInitial and defaultSamplingThereafter mirror the
+// defaults zap.NewProductionConfig applies: log the first 100 entries
+// per second at a given level+message, then every 100th after that.
+const (
+	defaultSamplingInitial    = 100
+	defaultSamplingThereafter = 100
+)
+
+// LoggerConfiguration describes the sinks InitLoggerWithConfig should build.
+// Console and file sinks are independent: each has its own enable flag,
+// level and encoding so operators can, for example, keep the console at
+// info/text for local development while shipping debug/json to a
+// rotating file for later inspection.
+type LoggerConfiguration struct {
+	// IsDev mirrors InitLogger's isDev parameter: it picks the base
+	// production/development encoder defaults and, unless EnableSampling
+	// overrides it, whether sampling defaults on or off.
+	IsDev bool
+
+	EnableConsole bool
+	ConsoleLevel  string
+	ConsoleJSON   bool
+
+	EnableFile   bool
+	FileLevel    string
+	FileJSON     bool
+	FileLocation string
+	MaxSizeMB    int
+	MaxBackups   int
+	MaxAgeDays   int
+	Compress     bool
+
+	// EnableSampling bounds log volume under load by dropping repeated
+	// entries: after SamplingInitial identical entries (same level +
+	// message) are logged within a second, only every SamplingThereafter'th
+	// one is. Leave it nil to use the IsDev-derived default (sampling on
+	// in production, off in development, matching zap.NewProductionConfig/
+	// NewDevelopmentConfig); set it to force sampling on or off regardless
+	// of IsDev. Leave SamplingInitial/SamplingThereafter unset to fall
+	// back to zap's own 100/100 production default.
+	EnableSampling     *bool
+	SamplingInitial    int
+	SamplingThereafter int
+}
+
+// InitLoggerWithConfig builds a logger out of independently configured
+// console and file sinks, tying them together with zapcore.NewTee. This
+// is the entry point to reach for when a plain InitLogger call isn't
+// enough, e.g. when file rotation is required.
+func InitLoggerWithConfig(cfg LoggerConfiguration, configCb ...func(*zap.Config)) error {
+	var config zap.Config
+	if cfg.IsDev {
+		config = zap.NewDevelopmentConfig()
+	} else {
+		config = zap.NewProductionConfig()
+	}
+	config.EncoderConfig.TimeKey = "timestamp"
+	config.EncoderConfig.EncodeTime = zapcore.ISO8601TimeEncoder
+	if len(configCb) > 0 {
+		configCb[0](&config)
+	}
+
+	var cores []zapcore.Core
+	var levels []zap.AtomicLevel
+
+	if cfg.EnableConsole {
+		encoder := newEncoder(config.EncoderConfig, cfg.ConsoleJSON)
+		level := resolveLevel(cfg.ConsoleLevel, config.Level)
+		cores = append(cores, zapcore.NewCore(encoder, zapcore.Lock(zapcore.AddSync(os.Stdout)), level))
+		levels = append(levels, level)
+	}
+
+	if cfg.EnableFile {
+		encoder := newEncoder(config.EncoderConfig, cfg.FileJSON)
+		level := resolveLevel(cfg.FileLevel, config.Level)
+		writer := &lumberjack.Logger{
+			Filename:   cfg.FileLocation,
+			MaxSize:    cfg.MaxSizeMB,
+			MaxBackups: cfg.MaxBackups,
+			MaxAge:     cfg.MaxAgeDays,
+			Compress:   cfg.Compress,
+		}
+		cores = append(cores, zapcore.NewCore(encoder, zapcore.AddSync(writer), level))
+		levels = append(levels, level)
+	}
+
+	enableSampling := !cfg.IsDev
+	if cfg.EnableSampling != nil {
+		enableSampling = *cfg.EnableSampling
+	}
+
+	var core zapcore.Core = zapcore.NewTee(cores...)
+	if enableSampling {
+		initial, thereafter := cfg.SamplingInitial, cfg.SamplingThereafter
+		if initial == 0 {
+			initial = defaultSamplingInitial
+		}
+		if thereafter == 0 {
+			thereafter = defaultSamplingThereafter
+		}
+		core = zapcore.NewSamplerWithOptions(core, time.Second, initial, thereafter)
+	}
+
+	rootCore.reset(core)
+	zapLogger = zap.New(rootCore, zap.AddCallerSkip(1))
+	if len(levels) > 0 {
+		Logger.level = levels[0]
+		Logger.extraLevels = levels[1:]
+	}
+	zap.RedirectStdLog(zapLogger)
+	return nil
+}
+
+func newEncoder(encoderConfig zapcore.EncoderConfig, useJSON bool) zapcore.Encoder {
+	if useJSON {
+		return zapcore.NewJSONEncoder(encoderConfig)
+	}
+	return zapcore.NewConsoleEncoder(encoderConfig)
+}
+
+// resolveLevel seeds a sink's AtomicLevel from explicit (e.g.
+// cfg.ConsoleLevel/cfg.FileLevel) when set; otherwise it reuses fallback
+// (config.Level) as-is, so a configCb override of config.Level keeps
+// controlling that sink's verbosity dynamically, same as Logger.SetLevel
+// does for AtomicLevels built by InitLogger.
+func resolveLevel(explicit string, fallback zap.AtomicLevel) zap.AtomicLevel {
+	if explicit == "" {
+		return fallback
+	}
+	return zap.NewAtomicLevelAt(getLogLevel(explicit))
+}
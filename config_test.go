@@ -0,0 +1,36 @@
+package helpers
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"go.uber.org/zap"
+)
+
+func TestInitLoggerWithConfigThreadsConfigCbIntoEncoder(t *testing.T) {
+	logPath := filepath.Join(t.TempDir(), "app.log")
+
+	err := InitLoggerWithConfig(LoggerConfiguration{
+		EnableFile:   true,
+		FileLevel:    LogLevelInfo,
+		FileJSON:     true,
+		FileLocation: logPath,
+	}, func(c *zap.Config) {
+		c.EncoderConfig.MessageKey = "message"
+	})
+	if err != nil {
+		t.Fatalf("InitLoggerWithConfig: %v", err)
+	}
+
+	Logger.LogInfo("req-1", "hello from test", nil)
+
+	data, err := os.ReadFile(logPath)
+	if err != nil {
+		t.Fatalf("ReadFile: %v", err)
+	}
+	if !strings.Contains(string(data), `"message":"hello from test"`) {
+		t.Errorf("expected configCb's MessageKey override to reach the file sink's encoder, got: %s", data)
+	}
+}
@@ -0,0 +1,137 @@
+package helpers
+
+import (
+	"context"
+
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+)
+
+// ctxKey is an unexported type so values stashed by this package can
+// never collide with keys set by other packages sharing the context.
+type ctxKey struct{}
+
+var loggerFieldsKey = ctxKey{}
+
+// WithContext returns a copy of ctx carrying fields, merged with any
+// fields already accumulated on ctx by a previous WithContext call.
+// Middleware can call this once per request (e.g. with a requestId
+// field) and downstream code picks the fields back up with FromContext
+// or one of the *Ctx logging helpers, without threading requestID
+// through every function signature.
+func (l *logger) WithContext(ctx context.Context, fields ...zap.Field) context.Context {
+	existing, _ := ctx.Value(loggerFieldsKey).([]zap.Field)
+	merged := append(append([]zap.Field{}, existing...), fields...)
+	return context.WithValue(ctx, loggerFieldsKey, merged)
+}
+
+// ctxLogger is returned by FromContext. Its Log* methods mirror the
+// package's *Ctx helpers, minus the ctx argument: they pull requestId,
+// traceId, spanId and any other fields WithContext accumulated on the
+// bound context instead of requiring the caller to pass them again.
+type ctxLogger struct {
+	ctx context.Context
+}
+
+// FromContext returns a logger bound to ctx, pre-populated with the
+// fields accumulated on it via WithContext.
+func (l *logger) FromContext(ctx context.Context) *ctxLogger {
+	return &ctxLogger{ctx: ctx}
+}
+
+// LogError logs the error in the proper format, using the requestId and
+// fields bound to c's context.
+func (c *ctxLogger) LogError(message string, err error, fields map[string]interface{}) error {
+	return Logger.LogErrorCtx(c.ctx, message, err, fields)
+}
+
+// LogWarn logs the warning message in the proper format, using the
+// requestId and fields bound to c's context.
+func (c *ctxLogger) LogWarn(message string, fields map[string]interface{}) {
+	Logger.LogWarnCtx(c.ctx, message, fields)
+}
+
+// LogInfo logs the info message in the proper format, using the
+// requestId and fields bound to c's context.
+func (c *ctxLogger) LogInfo(message string, fields map[string]interface{}) {
+	Logger.LogInfoCtx(c.ctx, message, fields)
+}
+
+// LogDebug logs the debug message in the proper format, using the
+// requestId and fields bound to c's context.
+func (c *ctxLogger) LogDebug(message string, fields map[string]interface{}) {
+	Logger.LogDebugCtx(c.ctx, message, fields)
+}
+
+func fieldsFromContext(ctx context.Context) []zap.Field {
+	fields, _ := ctx.Value(loggerFieldsKey).([]zap.Field)
+	return fields
+}
+
+// requestIDFromContext extracts a requestId field logged via WithContext,
+// falling back to internalRequestID when none was set.
+func requestIDFromContext(ctx context.Context) string {
+	for _, f := range fieldsFromContext(ctx) {
+		if f.Key == "requestId" && f.Type == zapcore.StringType {
+			return f.String
+		}
+	}
+	return internalRequestID
+}
+
+// LogErrorCtx logs an error, automatically attaching requestId, traceId,
+// spanId and any other fields accumulated on ctx via WithContext.
+func (l *logger) LogErrorCtx(ctx context.Context, message string, err error, fields map[string]interface{}) error {
+	requestID := requestIDFromContext(ctx)
+	value, ok := err.(Error)
+	if ok {
+		return value
+	}
+	extra := fieldsFromContext(ctx)
+	allFields := append(append([]zap.Field{}, extra...), zap.Any("error", errString(err)))
+	if fields != nil {
+		allFields = append(allFields, zap.Any("fields", fields))
+	}
+	zapLogger.Error(message, append(allFields, zap.String("requestId", requestID))...)
+	return Error{message: message, rawError: errString(err)}
+}
+
+// LogWarnCtx logs a warning, automatically attaching requestId, traceId,
+// spanId and any other fields accumulated on ctx via WithContext.
+func (l *logger) LogWarnCtx(ctx context.Context, message string, fields map[string]interface{}) {
+	requestID := requestIDFromContext(ctx)
+	allFields := append([]zap.Field{}, fieldsFromContext(ctx)...)
+	if fields != nil {
+		allFields = append(allFields, zap.Any("fields", fields))
+	}
+	zapLogger.Warn(message, append(allFields, zap.String("requestId", requestID))...)
+}
+
+// LogInfoCtx logs an info message, automatically attaching requestId,
+// traceId, spanId and any other fields accumulated on ctx via WithContext.
+func (l *logger) LogInfoCtx(ctx context.Context, message string, fields map[string]interface{}) {
+	requestID := requestIDFromContext(ctx)
+	allFields := append([]zap.Field{}, fieldsFromContext(ctx)...)
+	if fields != nil {
+		allFields = append(allFields, zap.Any("fields", fields))
+	}
+	zapLogger.Info(message, append(allFields, zap.String("requestId", requestID))...)
+}
+
+// LogDebugCtx logs a debug message, automatically attaching requestId,
+// traceId, spanId and any other fields accumulated on ctx via WithContext.
+func (l *logger) LogDebugCtx(ctx context.Context, message string, fields map[string]interface{}) {
+	requestID := requestIDFromContext(ctx)
+	allFields := append([]zap.Field{}, fieldsFromContext(ctx)...)
+	if fields != nil {
+		allFields = append(allFields, zap.Any("fields", fields))
+	}
+	zapLogger.Debug(message, append(allFields, zap.String("requestId", requestID))...)
+}
+
+func errString(err error) string {
+	if err == nil {
+		return ""
+	}
+	return err.Error()
+}
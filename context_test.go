@@ -0,0 +1,35 @@
+package helpers
+
+import (
+	"context"
+	"testing"
+
+	"go.uber.org/zap"
+)
+
+func TestWithContextMergesFields(t *testing.T) {
+	ctx := Logger.WithContext(context.Background(), zap.String("requestId", "abc"))
+	ctx = Logger.WithContext(ctx, zap.String("traceId", "trace-1"))
+
+	fields := fieldsFromContext(ctx)
+	if len(fields) != 2 {
+		t.Fatalf("expected 2 accumulated fields, got %d", len(fields))
+	}
+	if fields[0].Key != "requestId" || fields[0].String != "abc" {
+		t.Errorf("fields[0] = %+v, want requestId=abc", fields[0])
+	}
+	if fields[1].Key != "traceId" || fields[1].String != "trace-1" {
+		t.Errorf("fields[1] = %+v, want traceId=trace-1", fields[1])
+	}
+}
+
+func TestRequestIDFromContextFallsBackToInternal(t *testing.T) {
+	if got := requestIDFromContext(context.Background()); got != internalRequestID {
+		t.Errorf("requestIDFromContext(no fields) = %q, want %q", got, internalRequestID)
+	}
+
+	ctx := Logger.WithContext(context.Background(), zap.String("requestId", "req-1"))
+	if got := requestIDFromContext(ctx); got != "req-1" {
+		t.Errorf("requestIDFromContext = %q, want req-1", got)
+	}
+}
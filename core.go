@@ -0,0 +1,126 @@
+package helpers
+
+import (
+	"sync"
+
+	"go.uber.org/multierr"
+	"go.uber.org/zap/zapcore"
+)
+
+// lockedMultiCore fans a log entry out to a dynamic, named set of child
+// cores. Cores can be attached or detached at runtime via
+// Logger.AddCore/RemoveCore without rebuilding zapLogger; InitLogger and
+// InitLoggerWithConfig register the sink(s) they build under the
+// reserved "base" name.
+type lockedMultiCore struct {
+	mu    sync.RWMutex
+	names []string
+	cores []zapcore.Core
+}
+
+func newLockedMultiCore() *lockedMultiCore {
+	return &lockedMultiCore{}
+}
+
+func (c *lockedMultiCore) set(name string, core zapcore.Core) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for i, n := range c.names {
+		if n == name {
+			c.cores[i] = core
+			return
+		}
+	}
+	c.names = append(c.names, name)
+	c.cores = append(c.cores, core)
+}
+
+func (c *lockedMultiCore) remove(name string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for i, n := range c.names {
+		if n == name {
+			c.names = append(c.names[:i], c.names[i+1:]...)
+			c.cores = append(c.cores[:i], c.cores[i+1:]...)
+			return
+		}
+	}
+}
+
+// reset drops every registered core and replaces them with base under
+// the reserved "base" name. Used by InitLogger/InitLoggerWithConfig so
+// re-initializing the logger doesn't leave stale cores attached.
+func (c *lockedMultiCore) reset(base zapcore.Core) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.names = []string{"base"}
+	c.cores = []zapcore.Core{base}
+}
+
+func (c *lockedMultiCore) snapshot() []zapcore.Core {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	cores := make([]zapcore.Core, len(c.cores))
+	copy(cores, c.cores)
+	return cores
+}
+
+func (c *lockedMultiCore) Enabled(level zapcore.Level) bool {
+	for _, core := range c.snapshot() {
+		if core.Enabled(level) {
+			return true
+		}
+	}
+	return false
+}
+
+func (c *lockedMultiCore) With(fields []zapcore.Field) zapcore.Core {
+	cores := c.snapshot()
+	clone := make([]zapcore.Core, len(cores))
+	for i, core := range cores {
+		clone[i] = core.With(fields)
+	}
+	c.mu.RLock()
+	names := append([]string{}, c.names...)
+	c.mu.RUnlock()
+	return &lockedMultiCore{names: names, cores: clone}
+}
+
+func (c *lockedMultiCore) Check(entry zapcore.Entry, ce *zapcore.CheckedEntry) *zapcore.CheckedEntry {
+	for _, core := range c.snapshot() {
+		ce = core.Check(entry, ce)
+	}
+	return ce
+}
+
+func (c *lockedMultiCore) Write(entry zapcore.Entry, fields []zapcore.Field) error {
+	var err error
+	for _, core := range c.snapshot() {
+		err = multierr.Append(err, core.Write(entry, fields))
+	}
+	return err
+}
+
+func (c *lockedMultiCore) Sync() error {
+	var err error
+	for _, core := range c.snapshot() {
+		err = multierr.Append(err, core.Sync())
+	}
+	return err
+}
+
+var rootCore = newLockedMultiCore()
+
+// AddCore attaches an extra zapcore.Core under name, e.g. a Sentry core,
+// a Kafka-shipping core, or an adapter forwarding entries to a foreign
+// logging library (see FieldsToKeyValues). Calling AddCore again with
+// the same name replaces the previously registered core.
+func (l *logger) AddCore(name string, core zapcore.Core) {
+	rootCore.set(name, core)
+}
+
+// RemoveCore detaches the core previously registered under name. It is
+// a no-op if no core is registered under that name.
+func (l *logger) RemoveCore(name string) {
+	rootCore.remove(name)
+}
@@ -0,0 +1,42 @@
+package helpers
+
+import (
+	"testing"
+
+	"go.uber.org/zap/zapcore"
+)
+
+type spyCore struct {
+	entries []zapcore.Entry
+}
+
+func (s *spyCore) Enabled(zapcore.Level) bool        { return true }
+func (s *spyCore) With([]zapcore.Field) zapcore.Core { return s }
+func (s *spyCore) Sync() error                       { return nil }
+func (s *spyCore) Check(entry zapcore.Entry, ce *zapcore.CheckedEntry) *zapcore.CheckedEntry {
+	return ce.AddCore(entry, s)
+}
+func (s *spyCore) Write(entry zapcore.Entry, _ []zapcore.Field) error {
+	s.entries = append(s.entries, entry)
+	return nil
+}
+
+func TestAddCoreRemoveCoreFanOut(t *testing.T) {
+	if err := InitLogger(LogLevelInfo, LogFormatJSON, false); err != nil {
+		t.Fatalf("InitLogger: %v", err)
+	}
+
+	spy := &spyCore{}
+	Logger.AddCore("spy", spy)
+
+	zapLogger.Info("hello")
+	if len(spy.entries) != 1 {
+		t.Fatalf("expected 1 entry fanned out to the added core, got %d", len(spy.entries))
+	}
+
+	Logger.RemoveCore("spy")
+	zapLogger.Info("world")
+	if len(spy.entries) != 1 {
+		t.Fatalf("expected no more entries after RemoveCore, got %d", len(spy.entries))
+	}
+}
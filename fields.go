@@ -0,0 +1,64 @@
+package helpers
+
+import (
+	"fmt"
+	"math"
+	"time"
+
+	"go.uber.org/zap/zapcore"
+)
+
+// FieldsToKeyValues flattens zap fields into an alternating key/value
+// slice (key1, value1, key2, value2, ...), the shape expected by most
+// non-zap logging APIs. It's meant for adapter cores registered via
+// Logger.AddCore that forward entries to a foreign logger.
+func FieldsToKeyValues(fields []zapcore.Field) []interface{} {
+	kv := make([]interface{}, 0, len(fields)*2)
+	for _, f := range fields {
+		kv = append(kv, f.Key, fieldValue(f))
+	}
+	return kv
+}
+
+func fieldValue(f zapcore.Field) interface{} {
+	switch f.Type {
+	case zapcore.BoolType:
+		return f.Integer == 1
+	case zapcore.DurationType:
+		return time.Duration(f.Integer)
+	case zapcore.Float64Type:
+		return math.Float64frombits(uint64(f.Integer))
+	case zapcore.Float32Type:
+		return math.Float32frombits(uint32(f.Integer))
+	case zapcore.Int64Type, zapcore.Int32Type, zapcore.Int16Type, zapcore.Int8Type:
+		return f.Integer
+	case zapcore.Uint64Type, zapcore.Uint32Type, zapcore.Uint16Type, zapcore.Uint8Type:
+		return uint64(f.Integer)
+	case zapcore.StringType:
+		return f.String
+	case zapcore.TimeType:
+		if loc, ok := f.Interface.(*time.Location); ok {
+			return time.Unix(0, f.Integer).In(loc)
+		}
+		return time.Unix(0, f.Integer)
+	case zapcore.TimeFullType:
+		if t, ok := f.Interface.(time.Time); ok {
+			return t
+		}
+		return f.Interface
+	case zapcore.ErrorType:
+		if err, ok := f.Interface.(error); ok {
+			return err.Error()
+		}
+		return f.Interface
+	case zapcore.StringerType:
+		if s, ok := f.Interface.(fmt.Stringer); ok {
+			return s.String()
+		}
+		return f.Interface
+	case zapcore.ReflectType:
+		return f.Interface
+	default:
+		return f.Interface
+	}
+}
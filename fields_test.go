@@ -0,0 +1,76 @@
+package helpers
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+type stringerVal struct{}
+
+func (stringerVal) String() string { return "stringer-value" }
+
+func TestFieldsToKeyValues(t *testing.T) {
+	now := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+	fields := []zap.Field{
+		zap.Bool("bool", true),
+		zap.Duration("duration", 2*time.Second),
+		zap.Float64("float64", 1.5),
+		zap.Float32("float32", float32(2.5)),
+		zap.Int("int", 7),
+		zap.Uint("uint", 8),
+		zap.String("string", "value"),
+		zap.Error(errors.New("boom")),
+		zap.Stringer("stringer", stringerVal{}),
+		zap.Time("time", now),
+	}
+
+	kv := FieldsToKeyValues(fields)
+	if len(kv) != len(fields)*2 {
+		t.Fatalf("expected %d elements, got %d", len(fields)*2, len(kv))
+	}
+
+	get := func(key string) interface{} {
+		for i := 0; i < len(kv); i += 2 {
+			if kv[i] == key {
+				return kv[i+1]
+			}
+		}
+		t.Fatalf("key %q not found in %v", key, kv)
+		return nil
+	}
+
+	if v := get("bool"); v != true {
+		t.Errorf("bool = %v, want true", v)
+	}
+	if v := get("duration"); v != 2*time.Second {
+		t.Errorf("duration = %v, want 2s", v)
+	}
+	if v := get("float64"); v != 1.5 {
+		t.Errorf("float64 = %v, want 1.5", v)
+	}
+	if v := get("float32"); v != float32(2.5) {
+		t.Errorf("float32 = %v, want 2.5", v)
+	}
+	if v := get("int"); v != int64(7) {
+		t.Errorf("int = %v, want 7", v)
+	}
+	if v := get("uint"); v != uint64(8) {
+		t.Errorf("uint = %v, want 8", v)
+	}
+	if v := get("string"); v != "value" {
+		t.Errorf("string = %v, want value", v)
+	}
+	if v := get("error"); v != "boom" {
+		t.Errorf("error = %v, want boom", v)
+	}
+	if v := get("stringer"); v != "stringer-value" {
+		t.Errorf("stringer = %v, want stringer-value", v)
+	}
+	tv, ok := get("time").(time.Time)
+	if !ok || !tv.Equal(now) {
+		t.Errorf("time = %v, want %v", tv, now)
+	}
+}
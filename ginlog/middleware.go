@@ -0,0 +1,121 @@
+// Package ginlog wires the helpers logger into a Gin HTTP server: a
+// Middleware that emits structured access logs per request, and a
+// Recovery handler that turns panics into logged errors instead of
+// crashing the process.
+package ginlog
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+
+	"github.com/junfengye/helpers"
+)
+
+const requestIDHeader = "X-Request-ID"
+
+type config struct {
+	headerName string
+	generator  func() string
+}
+
+// Option customizes Middleware behavior.
+type Option func(*config)
+
+// WithHeaderName overrides the header used to read/propagate the
+// request ID. Defaults to X-Request-ID.
+func WithHeaderName(name string) Option {
+	return func(c *config) { c.headerName = name }
+}
+
+// WithGenerator overrides how a request ID is generated when the
+// incoming request doesn't already carry one.
+func WithGenerator(generator func() string) Option {
+	return func(c *config) { c.generator = generator }
+}
+
+func newConfig(opts ...Option) *config {
+	cfg := &config{headerName: requestIDHeader, generator: generateRequestID}
+	for _, opt := range opts {
+		opt(cfg)
+	}
+	return cfg
+}
+
+func generateRequestID() string {
+	buf := make([]byte, 16)
+	_, _ = rand.Read(buf)
+	return hex.EncodeToString(buf)
+}
+
+// Middleware generates or propagates a request ID, attaches it to the
+// request context via helpers.Logger.WithContext, and logs method,
+// path, status, latency, client IP, user agent and response size once
+// the request completes. The access log fires even if a downstream
+// handler panics and Recovery is registered ahead of Middleware in the
+// chain: Middleware logs from its own deferred func before re-panicking
+// so an outer Recovery still gets to log and recover the panic itself.
+func Middleware(opts ...Option) gin.HandlerFunc {
+	cfg := newConfig(opts...)
+	return func(c *gin.Context) {
+		requestID := c.GetHeader(cfg.headerName)
+		if requestID == "" {
+			requestID = cfg.generator()
+		}
+		c.Header(cfg.headerName, requestID)
+
+		ctx := helpers.Logger.WithContext(c.Request.Context(), zap.String("requestId", requestID))
+		c.Request = c.Request.WithContext(ctx)
+
+		start := time.Now()
+		defer func() {
+			r := recover()
+
+			helpers.Logger.LogInfoCtx(ctx, "http request", map[string]interface{}{
+				"method":    c.Request.Method,
+				"path":      c.Request.URL.Path,
+				"status":    c.Writer.Status(),
+				"latencyMs": time.Since(start).Milliseconds(),
+				"clientIp":  c.ClientIP(),
+				"userAgent": c.Request.UserAgent(),
+				"bytes":     c.Writer.Size(),
+			})
+
+			if r != nil {
+				panic(r)
+			}
+		}()
+		c.Next()
+	}
+}
+
+// Recovery recovers from panics raised by downstream handlers, logs the
+// stack trace via helpers.Logger, and responds with a bare 500 instead
+// of letting the panic reach Gin's default (unlogged) recovery.
+func Recovery() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		defer func() {
+			r := recover()
+			if r == nil {
+				return
+			}
+			// Read the context lazily so a requestId attached by
+			// Middleware (which may run after Recovery in the chain)
+			// is still picked up.
+			ctx := c.Request.Context()
+			err, ok := r.(error)
+			if !ok {
+				err = fmt.Errorf("%v", r)
+			}
+			_ = helpers.Logger.LogErrorCtx(ctx, "panic recovered", err, map[string]interface{}{
+				"stack": zap.Stack("stack").String,
+			})
+			c.AbortWithStatus(500)
+		}()
+		c.Next()
+	}
+}
@@ -0,0 +1,59 @@
+package ginlog
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/junfengye/helpers"
+)
+
+// Recovery is registered ahead of Middleware, the natural choice if you
+// also want Recovery to catch a panic inside Middleware itself. Because
+// defers unwind in the reverse order they're registered, Middleware's
+// deferred access log runs *before* Recovery's deferred panic log, so
+// both must appear regardless of a downstream panic.
+func TestMiddlewareLogsAccessEvenWhenRecoveryRunsFirst(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	logPath := filepath.Join(t.TempDir(), "access.log")
+	if err := helpers.InitLoggerWithConfig(helpers.LoggerConfiguration{
+		EnableFile:   true,
+		FileLevel:    helpers.LogLevelInfo,
+		FileJSON:     true,
+		FileLocation: logPath,
+	}); err != nil {
+		t.Fatalf("InitLoggerWithConfig: %v", err)
+	}
+
+	r := gin.New()
+	r.Use(Recovery(), Middleware())
+	r.GET("/boom", func(c *gin.Context) {
+		panic("kaboom")
+	})
+
+	req := httptest.NewRequest(http.MethodGet, "/boom", nil)
+	rec := httptest.NewRecorder()
+	r.ServeHTTP(rec, req)
+
+	if rec.Code != http.StatusInternalServerError {
+		t.Fatalf("status = %d, want %d", rec.Code, http.StatusInternalServerError)
+	}
+
+	data, err := os.ReadFile(logPath)
+	if err != nil {
+		t.Fatalf("ReadFile: %v", err)
+	}
+	log := string(data)
+	if !strings.Contains(log, `"http request"`) {
+		t.Errorf("expected an access log line despite the panic, got: %s", log)
+	}
+	if !strings.Contains(log, `"panic recovered"`) {
+		t.Errorf("expected a panic log line, got: %s", log)
+	}
+}
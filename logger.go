@@ -2,6 +2,7 @@ package helpers
 
 import (
 	"errors"
+	"net/http"
 
 	"go.uber.org/zap"
 	"go.uber.org/zap/zapcore"
@@ -14,11 +15,24 @@ const (
 
 	LogFormatJSON = "json"
 	LogFormatText = "text"
+
+	// internalRequestID marks log lines the package emits about itself
+	// (e.g. a bad log level/format falling back to a default) rather
+	// than on behalf of a caller-supplied request.
+	internalRequestID = "internal"
 )
 
 var Logger = &logger{}
 
 type logger struct {
+	// level is the atomic level backing the primary sink, exposed via
+	// SetLevel/GetLevel/LevelHandler so callers can flip verbosity at
+	// runtime without rebuilding the logger. extraLevels holds the
+	// atomic levels of any additional sinks (e.g. the rotating file
+	// sink from InitLoggerWithConfig) so a single SetLevel call keeps
+	// every sink in sync.
+	level       zap.AtomicLevel
+	extraLevels []zap.AtomicLevel
 }
 
 var zapLogger *zap.Logger
@@ -38,19 +52,50 @@ func InitLogger(loglevel, logFormat string, isDev bool, configCb ...func(*zap.Co
 		config = zap.NewProductionConfig()
 	}
 	config.Encoding = getLogFormat(logFormat)
-	config.Level.SetLevel(getLogLevel(loglevel))
+	config.Level = zap.NewAtomicLevelAt(getLogLevel(loglevel))
 	if len(configCb) > 0 {
 		configCb[0](&config)
 	}
-	var err error
-	zapLogger, err = config.Build(zap.AddCallerSkip(1))
+	zl, err := config.Build(zap.AddCallerSkip(1))
 	if err != nil {
 		return err
 	}
+	rootCore.reset(zl.Core())
+	zapLogger = zl.WithOptions(zap.WrapCore(func(zapcore.Core) zapcore.Core {
+		return rootCore
+	}))
+	Logger.level = config.Level
+	Logger.extraLevels = nil
 	zap.RedirectStdLog(zapLogger)
 	return nil
 }
 
+// SetLevel changes the verbosity of the logger, and of every additional
+// sink built by InitLoggerWithConfig, without requiring a restart. Cores
+// attached with AddCore keep their own levels.
+func (l *logger) SetLevel(level string) error {
+	var lvl zapcore.Level
+	if err := lvl.UnmarshalText([]byte(level)); err != nil {
+		return err
+	}
+	l.level.SetLevel(lvl)
+	for _, extra := range l.extraLevels {
+		extra.SetLevel(lvl)
+	}
+	return nil
+}
+
+// GetLevel returns the current verbosity of the logger's primary sink.
+func (l *logger) GetLevel() string {
+	return l.level.Level().String()
+}
+
+// LevelHandler exposes the atomic level over HTTP so it can be mounted
+// as a `/loglevel` endpoint, e.g. `http.Handle("/loglevel", helpers.Logger.LevelHandler())`.
+func (l *logger) LevelHandler() http.Handler {
+	return l.level
+}
+
 func getLogFormat(logFormat string) string {
 	switch logFormat {
 	case LogFormatJSON:
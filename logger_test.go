@@ -0,0 +1,33 @@
+package helpers
+
+import (
+	"path/filepath"
+	"testing"
+)
+
+func TestSetLevelReachesPerSinkLevels(t *testing.T) {
+	err := InitLoggerWithConfig(LoggerConfiguration{
+		EnableConsole: true,
+		ConsoleLevel:  LogLevelInfo,
+		EnableFile:    true,
+		FileLevel:     LogLevelInfo,
+		FileLocation:  filepath.Join(t.TempDir(), "app.log"),
+	})
+	if err != nil {
+		t.Fatalf("InitLoggerWithConfig: %v", err)
+	}
+
+	if err := Logger.SetLevel(LogLevelDebug); err != nil {
+		t.Fatalf("SetLevel: %v", err)
+	}
+
+	if got := Logger.GetLevel(); got != LogLevelDebug {
+		t.Errorf("console (primary) level = %q, want %q", got, LogLevelDebug)
+	}
+	if len(Logger.extraLevels) != 1 {
+		t.Fatalf("expected 1 extra (file) level, got %d", len(Logger.extraLevels))
+	}
+	if got := Logger.extraLevels[0].Level().String(); got != LogLevelDebug {
+		t.Errorf("file level = %q, want %q", got, LogLevelDebug)
+	}
+}